@@ -0,0 +1,136 @@
+package blnkgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// DefaultMaxBulkSize is the number of transactions CreateBulk sends per HTTP
+// call before chunking a larger slice into multiple requests.
+const DefaultMaxBulkSize = 100
+
+// BulkFailure describes a single transaction within a CreateBulk call that
+// the server rejected.
+type BulkFailure struct {
+	Index int
+	Input CreateTransactionRequest
+	Err   error
+}
+
+// BulkError is returned by CreateBulk when one or more transactions in the
+// batch failed, so the caller can retry just the failures instead of
+// resubmitting the whole batch. Err holds the request-level error (e.g. a
+// transport failure or non-retryable response) that aborted a later chunk,
+// if any; Failures still reports every per-item rejection collected from
+// chunks that did complete, so they aren't lost alongside it.
+type BulkError struct {
+	Failures []BulkFailure
+	Err      error
+}
+
+func (e *BulkError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("blnkgo: %d bulk transaction(s) failed, and a later chunk errored: %s", len(e.Failures), e.Err)
+	}
+	return fmt.Sprintf("blnkgo: %d bulk transaction(s) failed", len(e.Failures))
+}
+
+func (e *BulkError) Unwrap() error {
+	return e.Err
+}
+
+// bulkCreateRequest is the wire format CreateBulk posts for one chunk.
+type bulkCreateRequest struct {
+	IdempotencyKey string                     `json:"-"`
+	Transactions   []CreateTransactionRequest `json:"transactions"`
+}
+
+// GetIdempotencyKey implements IdempotencyKeyable.
+func (r bulkCreateRequest) GetIdempotencyKey() string {
+	return r.IdempotencyKey
+}
+
+type bulkCreateResponse struct {
+	Transactions []Transaction `json:"transactions"`
+	Failures     []struct {
+		Index   int    `json:"index"`
+		Message string `json:"message"`
+	} `json:"failures,omitempty"`
+}
+
+// CreateBulk posts transactions in chunks of at most Options.MaxBulkSize
+// (100 by default), validating every entry up front. Transactions the
+// server rejects are collected into a *BulkError alongside their index and
+// input, so the caller can retry only the failures instead of the whole
+// batch.
+func (s *TransactionService) CreateBulk(ctx context.Context, transactions []CreateTransactionRequest) ([]Transaction, *http.Response, error) {
+	for i, t := range transactions {
+		if err := ValidateCreateTransacation(t); err != nil {
+			return nil, nil, fmt.Errorf("transaction %d: %w", i, err)
+		}
+	}
+
+	maxBulkSize := s.client.Options.MaxBulkSize
+	if maxBulkSize <= 0 {
+		maxBulkSize = DefaultMaxBulkSize
+	}
+
+	var (
+		results  []Transaction
+		failures []BulkFailure
+		lastResp *http.Response
+	)
+
+	for start := 0; start < len(transactions); start += maxBulkSize {
+		end := start + maxBulkSize
+		if end > len(transactions) {
+			end = len(transactions)
+		}
+		chunk := transactions[start:end]
+
+		body := bulkCreateRequest{
+			IdempotencyKey: NewIdempotencyKey(),
+			Transactions:   chunk,
+		}
+
+		res := new(bulkCreateResponse)
+		resp, err := s.client.CallWithRetry(ctx, "transactions/bulk", http.MethodPost, body, res)
+		lastResp = resp
+		if err != nil {
+			//preserve whatever per-item failures earlier chunks already
+			//reported, rather than dropping them because a later chunk
+			//errored outright
+			if len(failures) > 0 {
+				return results, lastResp, &BulkError{Failures: failures, Err: err}
+			}
+			return results, lastResp, err
+		}
+
+		results = append(results, res.Transactions...)
+		for _, f := range res.Failures {
+			//guard against a malformed or buggy server response whose index
+			//doesn't actually fall within the chunk we sent
+			if f.Index < 0 || f.Index >= len(chunk) {
+				failures = append(failures, BulkFailure{
+					Index: start + f.Index,
+					Err:   fmt.Errorf("server reported failure for out-of-range index %d: %s", f.Index, f.Message),
+				})
+				continue
+			}
+
+			failures = append(failures, BulkFailure{
+				Index: start + f.Index,
+				Input: chunk[f.Index],
+				Err:   errors.New(f.Message),
+			})
+		}
+	}
+
+	if len(failures) > 0 {
+		return results, lastResp, &BulkError{Failures: failures}
+	}
+
+	return results, lastResp, nil
+}
@@ -2,6 +2,7 @@ package blnkgo
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/google/go-querystring/query"
+	"golang.org/x/time/rate"
 )
 
 type Client struct {
@@ -18,19 +20,73 @@ type Client struct {
 	BaseURL *url.URL
 	Options Options
 	client  *http.Client
+	limiter *rate.Limiter
 }
 
 type Options struct {
-	RetryCount int
-	Timeout    time.Duration
-	Logger     Logger
+	RetryPolicy RetryPolicy
+	Timeout     time.Duration
+	Logger      Logger
+	// MaxBulkSize caps how many transactions TransactionService.CreateBulk
+	// sends per HTTP call, chunking larger slices across multiple requests.
+	MaxBulkSize int
 }
 
 func DefaultOptions() Options {
 	return Options{
-		RetryCount: 3,
-		Timeout:    time.Second * 10,
-		Logger:     NewDefaultLogger(),
+		RetryPolicy: DefaultRetryPolicy(),
+		Timeout:     time.Second * 10,
+		Logger:      NewDefaultLogger(),
+		MaxBulkSize: DefaultMaxBulkSize,
+	}
+}
+
+// ClientOption configures a Client at construction time. See NewClient.
+type ClientOption func(*Client)
+
+// WithRetryPolicy overrides the default retry/backoff behavior of
+// CallWithRetry.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.Options.RetryPolicy = policy
+	}
+}
+
+// WithHTTPClient replaces the *http.Client used for all requests, e.g. to
+// share connection pooling with the rest of an application. It also adopts
+// hc.Timeout as Options.Timeout, so NewClient's post-option pass doesn't
+// clobber a deliberately longer (or shorter) timeout back to the default.
+func WithHTTPClient(hc *http.Client) ClientOption {
+	return func(c *Client) {
+		c.client = hc
+		c.Options.Timeout = hc.Timeout
+	}
+}
+
+// WithTransport sets the http.RoundTripper the client's *http.Client uses,
+// so callers can install LoggingTransport, OTelTransport, PrometheusTransport,
+// or their own middleware chain without replacing the whole *http.Client.
+func WithTransport(rt http.RoundTripper) ClientOption {
+	return func(c *Client) {
+		c.client.Transport = rt
+	}
+}
+
+// WithMaxBulkSize overrides how many transactions CreateBulk sends per HTTP
+// call before chunking a larger slice across multiple requests.
+func WithMaxBulkSize(n int) ClientOption {
+	return func(c *Client) {
+		c.Options.MaxBulkSize = n
+	}
+}
+
+// WithRateLimit caps outgoing requests to rps requests per second, allowing
+// bursts up to burst. Use it to keep batch jobs (nightly reconciliations,
+// bulk imports) from tripping the Blnk server's own rate limits. Pass
+// float64(rate.Inf) to disable limiting, e.g. in tests.
+func WithRateLimit(rps float64, burst int) ClientOption {
+	return func(c *Client) {
+		c.limiter = rate.NewLimiter(rate.Limit(rps), burst)
 	}
 }
 
@@ -41,6 +97,8 @@ func NewClient(baseURL *url.URL, apiKey *string, opts ...ClientOption) *Client {
 		BaseURL: baseURL,
 		Options: DefaultOptions(),
 		client:  &http.Client{Timeout: 10 * time.Second},
+		//unlimited by default; callers opt into throttling with WithRateLimit
+		limiter: rate.NewLimiter(rate.Inf, 0),
 	}
 	//if base url is nil or empty, return error
 	if baseURL == nil || baseURL.String() == "" {
@@ -63,8 +121,9 @@ func (c *Client) SetBaseURL(baseURL *url.URL) {
 	c.BaseURL = baseURL
 }
 
-func (c *Client) NewRequest(endpoint, method string, opt interface{}) (*http.Request, error) {
+func (c *Client) NewRequest(ctx context.Context, endpoint, method string, opt interface{}) (*http.Request, error) {
 	//creates and returns a new HTTP request
+	//ctx carries cancellation/deadlines through to the underlying http.Request
 	//endpoint is the API endpoint
 	//method is the HTTP method
 	//opt is the request body
@@ -95,7 +154,7 @@ func (c *Client) NewRequest(endpoint, method string, opt interface{}) (*http.Req
 		}
 	}
 
-	req, err := http.NewRequest(method, u.String(), bodyBuf)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bodyBuf)
 	if err != nil {
 		return nil, err
 	}
@@ -106,48 +165,171 @@ func (c *Client) NewRequest(endpoint, method string, opt interface{}) (*http.Req
 	}
 	req.Header.Add("Content-Type", "application/json")
 
+	//if opt carries an idempotency key, surface it as a header so the
+	//server can dedupe this request across retries
+	if ik, ok := opt.(IdempotencyKeyable); ok {
+		if key := ik.GetIdempotencyKey(); key != "" {
+			req.Header.Set("Idempotency-Key", key)
+		}
+	}
+
 	return req, nil
 }
 
-// to:Do implement retry strategies
-func (c *Client) CallWithRetry(endpoint, method string, opt, resBody interface{}) (*http.Response, error) {
-	retryCount := c.Options.RetryCount
+// CallWithRetry sends a request to endpoint, retrying per c.Options.RetryPolicy.
+// endpoint is used both to build the request URL and, for observability, as
+// the blnk.endpoint span attribute / Prometheus endpoint label. Callers that
+// interpolate a value (e.g. a transaction ID) into endpoint should use
+// CallWithRetryRoute instead, so that identifier doesn't leak into metric
+// label cardinality.
+func (c *Client) CallWithRetry(ctx context.Context, endpoint, method string, opt, resBody interface{}) (*http.Response, error) {
+	return c.callWithRetry(ctx, endpoint, endpoint, method, opt, resBody)
+}
+
+// CallWithRetryRoute behaves like CallWithRetry, but reports route (rather
+// than endpoint) as the blnk.endpoint span attribute and Prometheus
+// endpoint label. Use it when endpoint has an identifier interpolated into
+// it (e.g. "transactions/inflight/<id>") by passing a low-cardinality
+// template as route (e.g. "transactions/inflight/{id}").
+func (c *Client) CallWithRetryRoute(ctx context.Context, endpoint, route, method string, opt, resBody interface{}) (*http.Response, error) {
+	return c.callWithRetry(ctx, endpoint, route, method, opt, resBody)
+}
+
+func (c *Client) callWithRetry(ctx context.Context, endpoint, route, method string, opt, resBody interface{}) (*http.Response, error) {
+	policy := c.Options.RetryPolicy
+	idempotent := isIdempotent(method, opt)
 
 	var resp *http.Response
+	var err error
 
-	for i := 0; i < retryCount; i++ {
-		req, err := c.NewRequest(endpoint, method, opt)
+	for attempt := 0; attempt <= policy.MaxRetries; attempt++ {
+		//bail out immediately if the caller's context is already done, before
+		//spending another attempt or sleeping through a backoff
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+
+		//throttle to the configured rate before spending an attempt
+		if waitErr := c.limiter.Wait(ctx); waitErr != nil {
+			return nil, waitErr
+		}
+
+		var req *http.Request
+		req, err = c.NewRequest(withRequestMetadata(ctx, route, attempt), endpoint, method, opt)
 		if err != nil {
 			return nil, err
 		}
-		//print the request
+
 		resp, err = c.client.Do(req)
-		//print the resp
-		if err != nil {
-			c.Options.Logger.Info(err.Error())
-			time.Sleep(time.Second * 2)
-			continue
+		if err == nil && resp.StatusCode < 300 {
+			if decodeErr := c.DecodeResponse(resp, resBody); decodeErr != nil {
+				c.Options.Logger.Error(decodeErr.Error())
+				return resp, decodeErr
+			}
+			return resp, nil
 		}
 
-		if resp.StatusCode >= 500 {
+		if err != nil {
+			c.Options.Logger.Info(err.Error())
+		} else {
 			logString := fmt.Sprintf("Request failed with status code %v and Status %v", resp.StatusCode, resp.Status)
 			c.Options.Logger.Error(logString)
-			time.Sleep(time.Second * 2)
-			continue
 		}
 
-		//check resp
-		err = c.DecodeResponse(resp, resBody)
-		if err != nil {
-			c.Options.Logger.Error(err.Error())
-			return resp, err
+		//non-idempotent requests (a POST without an Idempotency-Key) can't be
+		//safely retried once they may have reached the server, so surface the
+		//failure immediately instead of risking a duplicate transaction
+		if !idempotent {
+			break
+		}
+
+		retry, delay := policy.shouldRetry(resp, err, attempt)
+		if !retry || attempt == policy.MaxRetries {
+			break
+		}
+
+		//resp is about to be discarded in favor of the next attempt's
+		//response; close its body now so the connection can be reused
+		//instead of leaking until the process exits
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		if waitErr := sleepOrDone(ctx, delay); waitErr != nil {
+			return nil, waitErr
 		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, c.DecodeResponse(resp, resBody)
+}
 
-		return resp, nil
+// requestMetadataKey namespaces context values CallWithRetry attaches per
+// attempt so RoundTripper middlewares (see transport.go) can label spans
+// and metrics without changing the http.RoundTripper signature.
+type requestMetadataKey int
+
+const (
+	endpointContextKey requestMetadataKey = iota
+	retryAttemptContextKey
+)
+
+// withRequestMetadata annotates ctx with the endpoint and attempt number
+// for the request about to be sent, for consumption by the built-in
+// observability transports.
+func withRequestMetadata(ctx context.Context, endpoint string, attempt int) context.Context {
+	ctx = context.WithValue(ctx, endpointContextKey, endpoint)
+	ctx = context.WithValue(ctx, retryAttemptContextKey, attempt)
+	return ctx
+}
+
+// EndpointFromContext returns the blnk-go endpoint associated with req's
+// context, if any. Used by the built-in RoundTripper middlewares.
+func EndpointFromContext(ctx context.Context) string {
+	endpoint, _ := ctx.Value(endpointContextKey).(string)
+	return endpoint
+}
+
+// RetryAttemptFromContext returns the zero-based retry attempt number
+// associated with req's context, if any. Used by the built-in RoundTripper
+// middlewares.
+func RetryAttemptFromContext(ctx context.Context) int {
+	attempt, _ := ctx.Value(retryAttemptContextKey).(int)
+	return attempt
+}
+
+// isIdempotent reports whether a request is safe to retry after it may
+// have already reached the server. GET, HEAD, OPTIONS, PUT and DELETE
+// always are; any other method (e.g. POST) only is once its body supplies
+// an Idempotency-Key the server can use to dedupe it.
+func isIdempotent(method string, opt interface{}) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	if ik, ok := opt.(IdempotencyKeyable); ok {
+		return ik.GetIdempotencyKey() != ""
 	}
 
-	defer resp.Body.Close()
-	return nil, errors.New("max retry count exceeded")
+	return false
+}
+
+// sleepOrDone waits for d, returning early with ctx.Err() if ctx is cancelled
+// or its deadline elapses before the wait completes.
+func sleepOrDone(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 // decode response, this function will take in a response, and an interface it'll then decode the response body into the interface
@@ -0,0 +1,80 @@
+package blnkgo
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// APIError represents an error response returned by the Blnk server, letting
+// callers branch on Code/StatusCode instead of matching on error strings.
+type APIError struct {
+	StatusCode int             `json:"-"`
+	Code       string          `json:"code"`
+	Message    string          `json:"message"`
+	RequestID  string          `json:"request_id"`
+	Raw        json.RawMessage `json:"-"`
+}
+
+func (e *APIError) Error() string {
+	if e.RequestID != "" {
+		return fmt.Sprintf("blnkgo: %d %s: %s (request_id=%s)", e.StatusCode, e.Code, e.Message, e.RequestID)
+	}
+	return fmt.Sprintf("blnkgo: %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// CheckResponse checks the API response for errors, and returns an *APIError
+// describing it if the status code falls outside the 2xx range. The response
+// body is fully read and restored so the caller holding resp can still read
+// it afterwards.
+func (c *Client) CheckResponse(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return &APIError{StatusCode: resp.StatusCode, Message: err.Error()}
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	apiErr := &APIError{StatusCode: resp.StatusCode, Raw: json.RawMessage(body)}
+	if len(body) > 0 {
+		//best effort: the server doesn't guarantee its error envelope is
+		//well-formed JSON on every failure path
+		_ = json.Unmarshal(body, apiErr)
+	}
+	if apiErr.RequestID == "" {
+		apiErr.RequestID = resp.Header.Get("X-Request-Id")
+	}
+	if apiErr.Message == "" {
+		apiErr.Message = resp.Status
+	}
+
+	return apiErr
+}
+
+// IsNotFound reports whether err is an APIError for a 404 response.
+func IsNotFound(err error) bool {
+	return apiErrorStatus(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is an APIError for a 409 response, e.g. a
+// duplicate reference on TransactionService.Create.
+func IsConflict(err error) bool {
+	return apiErrorStatus(err, http.StatusConflict)
+}
+
+// IsRateLimited reports whether err is an APIError for a 429 response.
+func IsRateLimited(err error) bool {
+	return apiErrorStatus(err, http.StatusTooManyRequests)
+}
+
+func apiErrorStatus(err error, statusCode int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
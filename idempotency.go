@@ -0,0 +1,31 @@
+package blnkgo
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// IdempotencyKeyable is implemented by request bodies that carry an
+// Idempotency-Key, letting Client.NewRequest emit it as a header so the
+// Blnk server can deduplicate a mutation that's retried after a network
+// hiccup instead of double-posting it.
+type IdempotencyKeyable interface {
+	GetIdempotencyKey() string
+}
+
+// NewIdempotencyKey returns a random UUIDv4 suitable for use as an
+// Idempotency-Key. CallWithRetry reuses whatever key a request body
+// carries across every retry attempt, so the server sees one logical
+// operation rather than a new one per attempt.
+func NewIdempotencyKey() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+
+	// set version (4) and variant (RFC 4122) bits
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
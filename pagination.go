@@ -0,0 +1,124 @@
+package blnkgo
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// ListOptions filters and paginates TransactionService.List. Cursor and
+// Limit drive pagination; the rest filter which transactions come back.
+// All fields are optional and encoded as query params via go-querystring.
+type ListOptions struct {
+	Cursor        string    `url:"cursor,omitempty"`
+	Limit         int       `url:"limit,omitempty"`
+	Status        string    `url:"status,omitempty"`
+	Reference     string    `url:"reference,omitempty"`
+	CreatedAfter  time.Time `url:"created_after,omitempty"`
+	CreatedBefore time.Time `url:"created_before,omitempty"`
+}
+
+// PageInfo describes a listing response's pagination state.
+type PageInfo struct {
+	NextCursor string `json:"next_cursor"`
+	HasMore    bool   `json:"has_more"`
+}
+
+type transactionListResponse struct {
+	Transactions []Transaction `json:"transactions"`
+	PageInfo     PageInfo      `json:"page_info"`
+}
+
+// TransactionIterator lazily pages through TransactionService.List results,
+// fetching one page at a time and following the server's cursor until
+// exhausted:
+//
+//	it := svc.List(ctx, nil)
+//	for it.Next() {
+//	    tx := it.Value()
+//	}
+//	if err := it.Err(); err != nil { ... }
+type TransactionIterator struct {
+	ctx     context.Context
+	service *TransactionService
+	opts    ListOptions
+
+	page     []Transaction
+	pageInfo PageInfo
+	index    int
+
+	started bool
+	err     error
+}
+
+// List returns an iterator over transactions matching opts. opts may be nil
+// to list without filters. The first page is fetched lazily, on the first
+// call to Next.
+func (s *TransactionService) List(ctx context.Context, opts *ListOptions) *TransactionIterator {
+	it := &TransactionIterator{ctx: ctx, service: s}
+	if opts != nil {
+		it.opts = *opts
+	}
+	return it
+}
+
+// Next advances the iterator, fetching the next page from the server once
+// the current page is exhausted. It returns false when there are no more
+// transactions or an error occurred; use Err to distinguish the two.
+func (it *TransactionIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	it.index++
+	if it.index < len(it.page) {
+		return true
+	}
+
+	if it.started && !it.pageInfo.HasMore {
+		return false
+	}
+
+	if err := it.fetchPage(); err != nil {
+		it.err = err
+		return false
+	}
+
+	it.index = 0
+	return len(it.page) > 0
+}
+
+func (it *TransactionIterator) fetchPage() error {
+	//only follow the server's cursor once we've fetched at least one page;
+	//the very first fetch should still honor a caller-supplied Cursor
+	if it.started {
+		it.opts.Cursor = it.pageInfo.NextCursor
+	}
+	it.started = true
+
+	res := new(transactionListResponse)
+	_, err := it.service.client.CallWithRetry(it.ctx, "transactions", http.MethodGet, it.opts, res)
+	if err != nil {
+		return err
+	}
+
+	it.page = res.Transactions
+	it.pageInfo = res.PageInfo
+	return nil
+}
+
+// Value returns the transaction at the iterator's current position. It's
+// only valid to call after a call to Next that returned true.
+func (it *TransactionIterator) Value() Transaction {
+	return it.page[it.index]
+}
+
+// Err returns the first error encountered while paging, if any.
+func (it *TransactionIterator) Err() error {
+	return it.err
+}
+
+// PageInfo returns the pagination state of the most recently fetched page.
+func (it *TransactionIterator) PageInfo() PageInfo {
+	return it.pageInfo
+}
@@ -0,0 +1,115 @@
+package blnkgo
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how CallWithRetry paces retries between attempts.
+// It mirrors the shape used by other Go API clients (e.g. Cloudflare's
+// RetryPolicy): a bounded exponential backoff with a pluggable predicate
+// for deciding whether a given response/error is worth retrying at all.
+type RetryPolicy struct {
+	MaxRetries    int
+	MinRetryDelay time.Duration
+	MaxRetryDelay time.Duration
+
+	// ShouldRetry decides whether the attempt should be retried and, if so,
+	// how long to wait before the next one. Returning a negative delay
+	// tells the caller to fall back to the policy's exponential backoff.
+	// Defaults to DefaultShouldRetry when left nil.
+	ShouldRetry func(resp *http.Response, err error, attempt int) (retry bool, delay time.Duration)
+}
+
+// DefaultRetryPolicy returns the retry policy blnk-go uses unless the
+// caller overrides it with WithRetryPolicy.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:    3,
+		MinRetryDelay: time.Second,
+		MaxRetryDelay: time.Second * 30,
+		ShouldRetry:   DefaultShouldRetry,
+	}
+}
+
+// DefaultShouldRetry retries transport errors, 429s, 503s, and any other
+// 5xx response. 429/503 responses that carry a Retry-After header honor it
+// instead of the policy's own backoff.
+func DefaultShouldRetry(resp *http.Response, err error, attempt int) (bool, time.Duration) {
+	if err != nil {
+		return true, -1
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		if d, ok := retryAfterDelay(resp); ok {
+			return true, d
+		}
+		return true, -1
+	}
+
+	if resp.StatusCode >= 500 {
+		return true, -1
+	}
+
+	return false, 0
+}
+
+// backoff returns MinRetryDelay*2^attempt capped at MaxRetryDelay, plus
+// uniform jitter in [0, delay/2) so many clients backing off at once don't
+// all hammer the Blnk server again at the exact same moment.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := float64(p.MinRetryDelay) * math.Pow(2, float64(attempt))
+	if max := float64(p.MaxRetryDelay); delay > max {
+		delay = max
+	}
+
+	jitter := rand.Float64() * (delay / 2)
+
+	return time.Duration(delay + jitter)
+}
+
+// shouldRetry consults the policy's ShouldRetry hook, falling back to
+// DefaultShouldRetry when the caller hasn't set one, and resolves a
+// negative delay to the policy's own backoff for the given attempt.
+func (p RetryPolicy) shouldRetry(resp *http.Response, err error, attempt int) (bool, time.Duration) {
+	fn := p.ShouldRetry
+	if fn == nil {
+		fn = DefaultShouldRetry
+	}
+
+	retry, delay := fn(resp, err, attempt)
+	if retry && delay < 0 {
+		delay = p.backoff(attempt)
+	}
+
+	return retry, delay
+}
+
+// retryAfterDelay parses a Retry-After header in either its delta-seconds
+// or HTTP-date form (RFC 7231 section 7.1.3).
+func retryAfterDelay(resp *http.Response) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
@@ -1,6 +1,7 @@
 package blnkgo
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
@@ -38,9 +39,31 @@ type CreateTransactionRequest struct {
 	InflightExpiryDate time.Time `json:"inflight_expiry_date,omitempty"`
 	ScheduledFor       time.Time `json:"scheduled_for,omitempty"`
 	AllowOverdraft     bool      `json:"allow_overdraft,omitempty"`
+	// IdempotencyKey, when set, is sent as the Idempotency-Key header so the
+	// server can dedupe this transaction if a retry reaches it more than
+	// once. Leave it empty to have Create generate one automatically.
+	IdempotencyKey string `json:"-"`
 	ParentTransaction
 }
 
+// GetIdempotencyKey implements IdempotencyKeyable.
+func (r CreateTransactionRequest) GetIdempotencyKey() string {
+	return r.IdempotencyKey
+}
+
+// RefundRequest is the body sent to TransactionService.Refund.
+type RefundRequest struct {
+	// IdempotencyKey, when set, is sent as the Idempotency-Key header so the
+	// server can dedupe this refund if a retry reaches it more than once.
+	// Leave it empty to have Refund generate one automatically.
+	IdempotencyKey string `json:"-"`
+}
+
+// GetIdempotencyKey implements IdempotencyKeyable.
+func (r RefundRequest) GetIdempotencyKey() string {
+	return r.IdempotencyKey
+}
+
 type Transaction struct {
 	ParentTransaction
 	TransactionID string `json:"transaction_id"`
@@ -50,34 +73,32 @@ type UpdateStatus struct {
 	Status InflightStatus `json:"status"`
 }
 
-func (s *TransactionService) Create(body CreateTransactionRequest) (*Transaction, *http.Response, error) {
+func (s *TransactionService) Create(ctx context.Context, body CreateTransactionRequest) (*Transaction, *http.Response, error) {
 	//validate the trannsaction
 	if err := ValidateCreateTransacation(body); err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("transactions", http.MethodPost, body)
-	if err != nil {
-		return nil, nil, err
+	//without a key CallWithRetry will refuse to retry this POST once it's
+	//been sent, so generate one when the caller hasn't supplied their own
+	if body.IdempotencyKey == "" {
+		body.IdempotencyKey = NewIdempotencyKey()
 	}
 
 	transaction := new(Transaction)
-	resp, err := s.client.CallWithRetry(req, transaction)
+	resp, err := s.client.CallWithRetry(ctx, "transactions", http.MethodPost, body, transaction)
 	if err != nil {
 		return nil, resp, err
 	}
 
 	return transaction, resp, nil
 }
-func (s *TransactionService) Update(transactionID string, body UpdateStatus) (*Transaction, *http.Response, error) {
+
+func (s *TransactionService) Update(ctx context.Context, transactionID string, body UpdateStatus) (*Transaction, *http.Response, error) {
 	u := fmt.Sprintf("transactions/inflight/%s", transactionID)
-	req, err := s.client.NewRequest(u, http.MethodPut, body)
-	if err != nil {
-		return nil, nil, err
-	}
 
 	transaction := new(Transaction)
-	resp, err := s.client.CallWithRetry(req, transaction)
+	resp, err := s.client.CallWithRetryRoute(ctx, u, "transactions/inflight/{id}", http.MethodPut, body, transaction)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -85,15 +106,17 @@ func (s *TransactionService) Update(transactionID string, body UpdateStatus) (*T
 	return transaction, resp, nil
 }
 
-func (s *TransactionService) Refund(transactionID string) (*Transaction, *http.Response, error) {
+func (s *TransactionService) Refund(ctx context.Context, transactionID string, body RefundRequest) (*Transaction, *http.Response, error) {
 	u := fmt.Sprintf("refund-transaction/%s", transactionID)
-	req, err := s.client.NewRequest(u, http.MethodPost, nil)
-	if err != nil {
-		return nil, nil, err
+
+	//without a key CallWithRetry will refuse to retry this POST once it's
+	//been sent, so generate one when the caller hasn't supplied their own
+	if body.IdempotencyKey == "" {
+		body.IdempotencyKey = NewIdempotencyKey()
 	}
 
 	transaction := new(Transaction)
-	resp, err := s.client.CallWithRetry(req, transaction)
+	resp, err := s.client.CallWithRetryRoute(ctx, u, "refund-transaction/{id}", http.MethodPost, body, transaction)
 	if err != nil {
 		return nil, resp, err
 	}
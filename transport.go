@@ -0,0 +1,148 @@
+package blnkgo
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RoundTripperFunc adapts a function to an http.RoundTripper, mirroring how
+// net/http's HandlerFunc adapts a function to a Handler.
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func nextOrDefault(rt http.RoundTripper) http.RoundTripper {
+	if rt != nil {
+		return rt
+	}
+	return http.DefaultTransport
+}
+
+// LoggingTransport logs method, URL, status and duration for every request
+// via Logger. It never logs the X-Blnk-Key header.
+type LoggingTransport struct {
+	Next   http.RoundTripper
+	Logger Logger
+}
+
+func (t *LoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	logger := t.Logger
+	if logger == nil {
+		logger = NewDefaultLogger()
+	}
+
+	start := time.Now()
+	resp, err := nextOrDefault(t.Next).RoundTrip(req)
+	duration := time.Since(start)
+
+	if err != nil {
+		logger.Error(fmt.Sprintf("%s %s failed after %s: %s", req.Method, req.URL, duration, err))
+		return resp, err
+	}
+
+	logger.Info(fmt.Sprintf("%s %s -> %d (%s)", req.Method, req.URL, resp.StatusCode, duration))
+	return resp, nil
+}
+
+// OTelTransport creates an OpenTelemetry span per request, tagged with the
+// blnk-go endpoint and retry attempt (see RetryAttemptFromContext). Each
+// span is started from the request's context, so the attempts of a retried
+// call share one trace only when the caller's ctx already carries a span.
+type OTelTransport struct {
+	Next   http.RoundTripper
+	Tracer trace.Tracer
+}
+
+func (t *OTelTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	tracer := t.Tracer
+	if tracer == nil {
+		tracer = otel.Tracer("github.com/blnkfinance/blnk-go")
+	}
+
+	ctx, span := tracer.Start(req.Context(), "blnkgo."+req.Method)
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("blnk.endpoint", EndpointFromContext(ctx)),
+		attribute.Int("blnk.retry_attempt", RetryAttemptFromContext(ctx)),
+	)
+
+	resp, err := nextOrDefault(t.Next).RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return resp, err
+	}
+
+	span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
+	return resp, nil
+}
+
+// PrometheusTransport records blnk_request_duration_seconds and
+// blnk_request_total, labeled by endpoint, method and status. Construct it
+// with NewPrometheusTransport, which registers those collectors against a
+// registry of the caller's choosing, rather than a global default registry
+// every importer of this package would be forced to share.
+type PrometheusTransport struct {
+	Next http.RoundTripper
+
+	requestDuration *prometheus.HistogramVec
+	requestTotal    *prometheus.CounterVec
+}
+
+// NewPrometheusTransport creates a PrometheusTransport whose metrics are
+// registered against reg (e.g. prometheus.DefaultRegisterer, or a
+// prometheus.NewRegistry() scoped to the caller). next is the RoundTripper
+// to delegate to; nil uses http.DefaultTransport.
+func NewPrometheusTransport(reg prometheus.Registerer, next http.RoundTripper) (*PrometheusTransport, error) {
+	requestDuration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "blnk_request_duration_seconds",
+		Help: "Duration of blnk-go HTTP requests, in seconds.",
+	}, []string{"endpoint", "method", "status"})
+
+	requestTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "blnk_request_total",
+		Help: "Count of blnk-go HTTP requests.",
+	}, []string{"endpoint", "method", "status"})
+
+	if err := reg.Register(requestDuration); err != nil {
+		return nil, err
+	}
+	if err := reg.Register(requestTotal); err != nil {
+		return nil, err
+	}
+
+	return &PrometheusTransport{
+		Next:            next,
+		requestDuration: requestDuration,
+		requestTotal:    requestTotal,
+	}, nil
+}
+
+func (t *PrometheusTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	endpoint := EndpointFromContext(req.Context())
+
+	start := time.Now()
+	resp, err := nextOrDefault(t.Next).RoundTrip(req)
+	duration := time.Since(start).Seconds()
+
+	status := "error"
+	if resp != nil {
+		status = strconv.Itoa(resp.StatusCode)
+	}
+
+	t.requestDuration.WithLabelValues(endpoint, req.Method, status).Observe(duration)
+	t.requestTotal.WithLabelValues(endpoint, req.Method, status).Inc()
+
+	return resp, err
+}